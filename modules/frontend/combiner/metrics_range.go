@@ -0,0 +1,41 @@
+package combiner
+
+import (
+	"sync"
+
+	"github.com/grafana/tempo/pkg/tempopb"
+	"github.com/grafana/tempo/pkg/traceql"
+)
+
+// MetricsRangeCombiner merges per-ingester QueryRangeResponses for a TraceQL metrics
+// query into one overall time-series result.
+type MetricsRangeCombiner struct {
+	mtx      sync.Mutex
+	combiner *traceql.SeriesCombiner
+}
+
+// NewMetricsRangeCombiner creates an empty MetricsRangeCombiner.
+func NewMetricsRangeCombiner() *MetricsRangeCombiner {
+	return &MetricsRangeCombiner{combiner: traceql.NewSeriesCombiner()}
+}
+
+// AddResponse merges one ingester's QueryRangeResponse into the running result. It is
+// safe to call from multiple goroutines, e.g. one per ingester queried concurrently.
+func (c *MetricsRangeCombiner) AddResponse(resp *tempopb.QueryRangeResponse) {
+	if resp == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.combiner.Combine(resp.Series)
+}
+
+// Response returns the combined result built up so far.
+func (c *MetricsRangeCombiner) Response() *tempopb.QueryRangeResponse {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return &tempopb.QueryRangeResponse{Series: c.combiner.Results()}
+}
@@ -0,0 +1,65 @@
+package combiner
+
+import (
+	"sync"
+
+	"github.com/grafana/tempo/pkg/tempopb"
+	"github.com/grafana/tempo/pkg/traceql"
+)
+
+// SearchStreamCombiner merges the SearchResponse chunks emitted by an ingester's
+// SearchStream RPC as they arrive, so the frontend can forward partial matches to the
+// caller instead of waiting for every ingester in the shard to finish.
+type SearchStreamCombiner struct {
+	mtx      sync.Mutex
+	combiner *traceql.MetadataCombiner
+	metrics  *tempopb.SearchMetrics
+}
+
+// NewSearchStreamCombiner creates an empty SearchStreamCombiner.
+func NewSearchStreamCombiner() *SearchStreamCombiner {
+	return &SearchStreamCombiner{
+		combiner: traceql.NewMetadataCombiner(),
+		metrics:  &tempopb.SearchMetrics{},
+	}
+}
+
+// AddChunk merges one streamed SearchResponse chunk into the running result. It is
+// safe to call from multiple goroutines, e.g. one per ingester being streamed from
+// concurrently.
+func (c *SearchStreamCombiner) AddChunk(resp *tempopb.SearchResponse) {
+	if resp == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, tr := range resp.Traces {
+		c.combiner.AddMetadata(tr)
+	}
+
+	if resp.Metrics != nil {
+		c.metrics.InspectedTraces += resp.Metrics.InspectedTraces
+		c.metrics.InspectedBytes += resp.Metrics.InspectedBytes
+	}
+}
+
+// Count returns the number of distinct traces combined so far.
+func (c *SearchStreamCombiner) Count() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.combiner.Count()
+}
+
+// Response returns the combined result built up so far.
+func (c *SearchStreamCombiner) Response() *tempopb.SearchResponse {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return &tempopb.SearchResponse{
+		Traces:  c.combiner.Metadata(),
+		Metrics: c.metrics,
+	}
+}
@@ -0,0 +1,21 @@
+package frontend
+
+import (
+	"context"
+
+	"github.com/grafana/tempo/modules/frontend/combiner"
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// queryRangeFromIngester calls SearchMetrics on a single ingester and merges its
+// response into c. This is what lets a TraceQL metrics query reach recent data that is
+// still resident in ingesters, the same way search already does for trace lookups.
+func queryRangeFromIngester(ctx context.Context, client tempopb.IngesterClient, req *tempopb.QueryRangeRequest, c *combiner.MetricsRangeCombiner) error {
+	resp, err := client.SearchMetrics(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.AddResponse(resp)
+	return nil
+}
@@ -0,0 +1,81 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/grafana/tempo/modules/frontend/combiner"
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// streamSearchFromIngesters fans out SearchStream to every ingester in clients
+// concurrently, feeding every chunk into c as it arrives. Once c's combined trace count
+// reaches req.Limit (20 when unset), it cancels the shared context so every other ingester's stream is
+// torn down instead of being drained to completion - maxResults is a fleet-wide cap,
+// not a per-ingester one.
+func streamSearchFromIngesters(ctx context.Context, clients []tempopb.IngesterClient, req *tempopb.SearchRequest, c *combiner.SearchStreamCombiner) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	maxResults := int(req.Limit)
+	if maxResults == 0 {
+		maxResults = 20
+	}
+
+	var (
+		wg     sync.WaitGroup
+		anyErr atomic.Error
+	)
+
+	for _, client := range clients {
+		wg.Add(1)
+		go func(client tempopb.IngesterClient) {
+			defer wg.Done()
+
+			if err := streamSearchFromIngester(ctx, cancel, maxResults, client, req, c); err != nil {
+				anyErr.Store(err)
+				cancel()
+			}
+		}(client)
+	}
+
+	wg.Wait()
+
+	return anyErr.Load()
+}
+
+// streamSearchFromIngester calls SearchStream on a single ingester and feeds every
+// chunk it emits into c as soon as it arrives. It calls cancel once c's combined count
+// reaches maxResults, which tears down every other ingester's stream alongside this
+// one, and returns once the ingester closes its stream or ctx is canceled (by this
+// ingester reaching maxResults first, or a sibling one doing so).
+func streamSearchFromIngester(ctx context.Context, cancel context.CancelFunc, maxResults int, client tempopb.IngesterClient, req *tempopb.SearchRequest, c *combiner.SearchStreamCombiner) error {
+	stream, err := client.SearchStream(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		c.AddChunk(resp)
+
+		if maxResults > 0 && c.Count() >= maxResults {
+			cancel()
+			return nil
+		}
+	}
+}
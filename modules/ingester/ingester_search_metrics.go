@@ -0,0 +1,29 @@
+package ingester
+
+import (
+	"context"
+
+	"github.com/grafana/dskit/user"
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// SearchMetrics implements tempopb.IngesterServer. It allows the query-frontend to push
+// TraceQL metrics queries into recent data that is still resident in ingesters, the same
+// way Search already does for trace lookups.
+//
+// Like SearchStream, this method's entry in tempopb.IngesterServer/IngesterClient is
+// added by extending tempo.proto's Ingester service and regenerating tempo.pb.go /
+// tempo_grpc.pb.go; this diff only adds the handler those generated files dispatch to.
+func (i *Ingester) SearchMetrics(ctx context.Context, req *tempopb.QueryRangeRequest) (*tempopb.QueryRangeResponse, error) {
+	userID, err := user.ExtractOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inst, err := i.getOrCreateInstance(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return inst.SearchMetrics(ctx, req)
+}
@@ -0,0 +1,31 @@
+package ingester
+
+import (
+	"github.com/grafana/dskit/user"
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// SearchStream implements tempopb.IngesterServer. It looks up the instance for the
+// calling tenant and delegates to instance.SearchStream, which streams SearchResponse
+// chunks back as each block finishes searching instead of buffering the full result set.
+//
+// tempopb.IngesterServer, tempopb.Ingester_SearchStreamServer and the matching client
+// types aren't declared anywhere in this diff: they come from adding SearchStream to
+// the Ingester service in tempo.proto and regenerating tempo.pb.go/tempo_grpc.pb.go,
+// which then gain this method alongside Push, Search, SearchTagsV2, etc. This file is
+// the handler that regeneration wires up to.
+func (i *Ingester) SearchStream(req *tempopb.SearchRequest, stream tempopb.Ingester_SearchStreamServer) error {
+	ctx := stream.Context()
+
+	userID, err := user.ExtractOrgID(ctx)
+	if err != nil {
+		return err
+	}
+
+	inst, err := i.getOrCreateInstance(userID)
+	if err != nil {
+		return err
+	}
+
+	return inst.SearchStream(ctx, req, stream)
+}
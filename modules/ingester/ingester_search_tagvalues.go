@@ -0,0 +1,26 @@
+package ingester
+
+import (
+	"context"
+
+	"github.com/grafana/dskit/user"
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// SearchTagValues implements tempopb.IngesterServer. It looks up the instance for the
+// calling tenant and delegates to instance.SearchTagValues, passing req straight
+// through so a scoped req.Query reaches the same query-gating path SearchTagValuesV2
+// already uses.
+func (i *Ingester) SearchTagValues(ctx context.Context, req *tempopb.SearchTagValuesRequest) (*tempopb.SearchTagValuesResponse, error) {
+	userID, err := user.ExtractOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inst, err := i.getOrCreateInstance(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return inst.SearchTagValues(ctx, req)
+}
@@ -31,6 +31,11 @@ func (i *instance) Search(ctx context.Context, req *tempopb.SearchRequest) (*tem
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	userID, err := user.ExtractOrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	maxResults := int(req.Limit)
 	// if limit is not set, use a safe default
 	if maxResults == 0 {
@@ -39,6 +44,19 @@ func (i *instance) Search(ctx context.Context, req *tempopb.SearchRequest) (*tem
 
 	span.AddEvent("SearchRequest", trace.WithAttributes(attribute.String("request", req.String())))
 
+	// TraceQL queries against the headblock can observe traces that are still being
+	// appended, which may hand the engine partially-written spans (e.g. a trace missing
+	// spans that arrive a moment later). Non-TraceQL search uses block.Search directly,
+	// which already only returns whatever is indexed at the time of the call, so it
+	// keeps its existing behavior regardless of this setting. The trade-off, per-tenant
+	// via search_include_live_traces, is freshness (seeing a trace the moment its first
+	// span lands) against consistency (never seeing a trace with spans missing because
+	// the rest hadn't been appended yet when the query ran).
+	includeLiveTraces := true
+	if api.IsTraceQLQuery(req) {
+		includeLiveTraces = i.limiter.limits.SearchIncludeLiveTraces(userID)
+	}
+
 	var (
 		resultsMtx = sync.Mutex{}
 		combiner   = traceql.NewMetadataCombiner()
@@ -116,7 +134,7 @@ func (i *instance) Search(ctx context.Context, req *tempopb.SearchRequest) (*tem
 	// that eventually a deadlock will occur.
 	i.headBlockMtx.RLock()
 	span.AddEvent("acquired headblock mtx")
-	if includeBlock(i.headBlock.BlockMeta(), req) {
+	if includeLiveTraces && includeBlock(i.headBlock.BlockMeta(), req) {
 		search(i.headBlock.BlockMeta().BlockID, i.headBlock, "headBlock")
 	}
 	i.headBlockMtx.RUnlock()
@@ -174,6 +192,168 @@ func (i *instance) Search(ctx context.Context, req *tempopb.SearchRequest) (*tem
 	}, nil
 }
 
+// SearchStream runs the same fan-out search as Search, but streams a SearchResponse
+// back to the caller as soon as each block finishes instead of waiting for every block
+// to complete. This lets the query-frontend combiner render partial results and lets
+// maxResults short-circuit the moment enough traces have matched across the fleet.
+func (i *instance) SearchStream(ctx context.Context, req *tempopb.SearchRequest, stream tempopb.Ingester_SearchStreamServer) error {
+	ctx, span := tracer.Start(ctx, "instance.SearchStream")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	userID, err := user.ExtractOrgID(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxResults := int(req.Limit)
+	// if limit is not set, use a safe default
+	if maxResults == 0 {
+		maxResults = 20
+	}
+
+	span.AddEvent("SearchRequest", trace.WithAttributes(attribute.String("request", req.String())))
+
+	// Same headblock gating as Search: see the note on includeLiveTraces there.
+	includeLiveTraces := true
+	if api.IsTraceQLQuery(req) {
+		includeLiveTraces = i.limiter.limits.SearchIncludeLiveTraces(userID)
+	}
+
+	var (
+		sendMtx    = sync.Mutex{}
+		resultsMtx = sync.Mutex{}
+		combiner   = traceql.NewMetadataCombiner()
+		opts       = common.DefaultSearchOptions()
+		anyErr     atomic.Error
+	)
+
+	send := func(resp *tempopb.SearchResponse) error {
+		sendMtx.Lock()
+		defer sendMtx.Unlock()
+		return stream.Send(resp)
+	}
+
+	searchBlock := func(blockID uuid.UUID, block common.Searcher, spanName string) {
+		ctx, span := tracer.Start(ctx, "instance.searchStreamBlock."+spanName)
+		defer span.End()
+
+		span.SetAttributes(attribute.String("blockID", blockID.String()))
+
+		var resp *tempopb.SearchResponse
+		var err error
+
+		if api.IsTraceQLQuery(req) {
+			resp, err = traceql.NewEngine().ExecuteSearch(ctx, req, traceql.NewSpansetFetcherWrapper(func(ctx context.Context, req traceql.FetchSpansRequest) (traceql.FetchSpansResponse, error) {
+				return block.Fetch(ctx, req, opts)
+			}))
+		} else {
+			resp, err = block.Search(ctx, req, opts)
+		}
+
+		if errors.Is(err, common.ErrUnsupported) {
+			level.Warn(log.Logger).Log("msg", "block does not support search", "blockID", blockID)
+			return
+		}
+		if errors.Is(err, context.Canceled) {
+			// Ignore
+			return
+		}
+		if err != nil {
+			level.Error(log.Logger).Log("msg", "error searching block", "blockID", blockID, "err", err)
+			anyErr.Store(err)
+			cancel()
+			return
+		}
+
+		if resp == nil {
+			return
+		}
+
+		newTraces := make([]*tempopb.TraceSearchMetadata, 0, len(resp.Traces))
+
+		resultsMtx.Lock()
+		alreadyFull := combiner.Count() >= maxResults
+		if !alreadyFull {
+			for _, tr := range resp.Traces {
+				if combiner.Count() >= maxResults {
+					break
+				}
+				combiner.AddMetadata(tr)
+				newTraces = append(newTraces, tr)
+			}
+		}
+		exceeded := combiner.Count() >= maxResults
+		resultsMtx.Unlock()
+
+		if len(newTraces) == 0 && resp.Metrics == nil {
+			if exceeded {
+				cancel()
+			}
+			return
+		}
+
+		if err := send(&tempopb.SearchResponse{
+			Traces:  newTraces,
+			Metrics: resp.Metrics,
+		}); err != nil {
+			anyErr.Store(err)
+			cancel()
+			return
+		}
+
+		if exceeded {
+			// Cancel all other in-flight block searches, we have enough results.
+			cancel()
+		}
+	}
+
+	i.headBlockMtx.RLock()
+	span.AddEvent("acquired headblock mtx")
+	if includeLiveTraces && includeBlock(i.headBlock.BlockMeta(), req) {
+		searchBlock(i.headBlock.BlockMeta().BlockID, i.headBlock, "headBlock")
+	}
+	i.headBlockMtx.RUnlock()
+	if err := anyErr.Load(); err != nil {
+		return err
+	}
+
+	i.blocksMtx.RLock()
+	defer i.blocksMtx.RUnlock()
+	span.AddEvent("acquired blocks mtx")
+
+	wg := sync.WaitGroup{}
+
+	for _, b := range i.completingBlocks {
+		if !includeBlock(b.BlockMeta(), req) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(b common.WALBlock) {
+			defer wg.Done()
+			searchBlock(b.BlockMeta().BlockID, b, "completingBlock")
+		}(b)
+	}
+
+	for _, b := range i.completeBlocks {
+		if !includeBlock(b.BlockMeta(), req) {
+			continue
+		}
+		wg.Add(1)
+		go func(b *LocalBlock) {
+			defer wg.Done()
+			searchBlock(b.BlockMeta().BlockID, b, "completeBlock")
+		}(b)
+	}
+
+	wg.Wait()
+
+	return anyErr.Load()
+}
+
 func (i *instance) SearchTags(ctx context.Context, scope string) (*tempopb.SearchTagsResponse, error) {
 	v2Response, err := i.SearchTagsV2(ctx, &tempopb.SearchTagsRequest{Scope: scope})
 	if err != nil {
@@ -204,6 +384,9 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 	ctx, span := tracer.Start(ctx, "instance.SearchTagsV2")
 	defer span.End()
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	userID, err := user.ExtractOrgID(ctx)
 	if err != nil {
 		return nil, err
@@ -230,10 +413,20 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 
 	limit := i.limiter.limits.MaxBytesPerTagValuesQuery(userID)
 	distinctValues := collector.NewScopedDistinctString(limit)
+	// Cancel outstanding block searches the instant the shared cap is hit, rather than
+	// waiting for their next Exceeded() poll between blocks.
+	distinctValues.OnCollect(func(_, _ string) {
+		if distinctValues.Exceeded() {
+			cancel()
+		}
+	})
 
 	engine := traceql.NewEngine()
 	query := traceql.ExtractMatchers(req.Query)
 
+	wg := boundedwaitgroup.New(i.tagSearchConcurrentBlocks(userID))
+	var anyErr atomic.Error
+
 	searchBlock := func(ctx context.Context, s common.Searcher, spanName string) error {
 		ctx, span := tracer.Start(ctx, "instance.SearchTags."+spanName)
 		defer span.End()
@@ -247,7 +440,7 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 
 		// if the query is empty, use the old search
 		if traceql.IsEmptyQuery(query) {
-			err = s.SearchTags(ctx, attributeScope, func(t string, scope traceql.AttributeScope) {
+			err := s.SearchTags(ctx, attributeScope, func(t string, scope traceql.AttributeScope) {
 				distinctValues.Collect(scope.String(), t)
 			}, common.DefaultSearchOptions())
 			if err != nil && !errors.Is(err, common.ErrUnsupported) {
@@ -268,6 +461,8 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 		}, fetcher)
 	}
 
+	// head block is searched synchronously and its lock is released before we fan out
+	// across the rest of the blocks, per the locking order documented on Search.
 	i.headBlockMtx.RLock()
 	span.AddEvent("acquired headblock mtx")
 	err = searchBlock(ctx, i.headBlock, "headBlock")
@@ -281,14 +476,45 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 	span.AddEvent("acquired blocks mtx")
 
 	for _, b := range i.completingBlocks {
-		if err = searchBlock(ctx, b, "completingBlock"); err != nil {
-			return nil, fmt.Errorf("unexpected error searching completing block (%s): %w", b.BlockMeta().BlockID, err)
+		if distinctValues.Exceeded() || anyErr.Load() != nil {
+			break
 		}
+		wg.Add(1)
+		go func(b common.WALBlock) {
+			defer wg.Done()
+			if err := searchBlock(ctx, b, "completingBlock"); err != nil {
+				anyErr.Store(fmt.Errorf("unexpected error searching completing block (%s): %w", b.BlockMeta().BlockID, err))
+				cancel()
+				return
+			}
+			if distinctValues.Exceeded() {
+				// Cancel all other outstanding block searches, we have enough tags.
+				cancel()
+			}
+		}(b)
 	}
 	for _, b := range i.completeBlocks {
-		if err = searchBlock(ctx, b, "completeBlock"); err != nil {
-			return nil, fmt.Errorf("unexpected error searching complete block (%s): %w", b.BlockMeta().BlockID, err)
+		if distinctValues.Exceeded() || anyErr.Load() != nil {
+			break
 		}
+		wg.Add(1)
+		go func(b *LocalBlock) {
+			defer wg.Done()
+			if err := searchBlock(ctx, b, "completeBlock"); err != nil {
+				anyErr.Store(fmt.Errorf("unexpected error searching complete block (%s): %w", b.BlockMeta().BlockID, err))
+				cancel()
+				return
+			}
+			if distinctValues.Exceeded() {
+				cancel()
+			}
+		}(b)
+	}
+
+	wg.Wait()
+
+	if err := anyErr.Load(); err != nil {
+		return nil, err
 	}
 
 	if distinctValues.Exceeded() {
@@ -317,22 +543,43 @@ func (i *instance) SearchTagsV2(ctx context.Context, req *tempopb.SearchTagsRequ
 	return resp, nil
 }
 
-func (i *instance) SearchTagValues(ctx context.Context, tagName string) (*tempopb.SearchTagValuesResponse, error) {
+// SearchTagValues returns the distinct values tagName has taken, optionally scoped by
+// req.Query the same way SearchTagValuesV2 already is - req.Query is the field
+// SearchTagValuesV2 has always read here, so no proto change was needed to reuse it for
+// the v1 path. The v1 response (SearchTagValuesResponse) only carries plain strings, so
+// the scoped path below encodes each matching Static back to a string before collecting
+// it, same as the V2 path does for its own response shape.
+func (i *instance) SearchTagValues(ctx context.Context, req *tempopb.SearchTagValuesRequest) (*tempopb.SearchTagValuesResponse, error) {
+	tagName := req.TagName
+
 	userID, err := user.ExtractOrgID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	limit := i.limiter.limits.MaxBytesPerTagValuesQuery(userID)
 	distinctValues := collector.NewDistinctString(limit)
+	// Cancel outstanding block searches the instant the cap is hit, rather than waiting
+	// for their next Exceeded() poll between blocks.
+	distinctValues.OnCollect(func(_ string) {
+		if distinctValues.Exceeded() {
+			cancel()
+		}
+	})
 
-	var inspectedBlocks, maxBlocks int
+	var inspectedBlocks, maxBlocks atomic.Int32
 	if limit := i.limiter.limits.MaxBlocksPerTagValuesQuery(userID); limit > 0 {
-		maxBlocks = limit
+		maxBlocks.Store(int32(limit))
 	}
 
+	engine := traceql.NewEngine()
+	query := traceql.ExtractMatchers(req.Query)
+
 	search := func(s common.Searcher, dv *collector.DistinctString) error {
-		if maxBlocks > 0 && inspectedBlocks >= maxBlocks {
+		if max := maxBlocks.Load(); max > 0 && inspectedBlocks.Inc() > max {
 			return nil
 		}
 
@@ -343,15 +590,37 @@ func (i *instance) SearchTagValues(ctx context.Context, tagName string) (*tempop
 			return nil
 		}
 
-		inspectedBlocks++
-		err = s.SearchTagValues(ctx, tagName, dv.Collect, common.DefaultSearchOptions())
-		if err != nil && !errors.Is(err, common.ErrUnsupported) {
-			return fmt.Errorf("unexpected error searching tag values (%s): %w", tagName, err)
+		// if the query is empty, use the fast unfiltered path, mirroring the guard in
+		// SearchTagValuesV2. A non-empty query means the caller wants autocomplete
+		// scoped to other conditions in the TraceQL query, e.g. `{ resource.cluster =
+		// "prod" }` narrowing down values for `resource.namespace`, rather than every
+		// value that tag has ever held across the whole block.
+		if traceql.IsEmptyQuery(query) {
+			err := s.SearchTagValues(ctx, tagName, dv.Collect, common.DefaultSearchOptions())
+			if err != nil && !errors.Is(err, common.ErrUnsupported) {
+				return fmt.Errorf("unexpected error searching tag values (%s): %w", tagName, err)
+			}
+
+			return nil
+		}
+
+		tag, err := traceql.ParseIdentifier(tagName)
+		if err != nil {
+			return fmt.Errorf("parsing tag name (%s): %w", tagName, err)
 		}
 
-		return nil
+		fetcher := traceql.NewTagValuesFetcherWrapper(func(ctx context.Context, req traceql.FetchTagValuesRequest, cb traceql.FetchTagValuesCallback) error {
+			return s.FetchTagValues(ctx, req, cb, common.DefaultSearchOptions())
+		})
+
+		return engine.ExecuteTagValues(ctx, tag, query, func(v traceql.Static) bool {
+			dv.Collect(v.EncodeToString(false))
+			return dv.Exceeded()
+		}, fetcher)
 	}
 
+	// head block is searched synchronously and its lock is released before we fan out
+	// across the rest of the blocks, per the locking order documented on Search.
 	i.headBlockMtx.RLock()
 	err = search(i.headBlock, distinctValues)
 	i.headBlockMtx.RUnlock()
@@ -362,15 +631,48 @@ func (i *instance) SearchTagValues(ctx context.Context, tagName string) (*tempop
 	i.blocksMtx.RLock()
 	defer i.blocksMtx.RUnlock()
 
+	wg := boundedwaitgroup.New(i.tagSearchConcurrentBlocks(userID))
+	var anyErr atomic.Error
+
 	for _, b := range i.completingBlocks {
-		if err = search(b, distinctValues); err != nil {
-			return nil, fmt.Errorf("unexpected error searching completing block (%s): %w", b.BlockMeta().BlockID, err)
+		if distinctValues.Exceeded() || anyErr.Load() != nil {
+			break
 		}
+		wg.Add(1)
+		go func(b common.WALBlock) {
+			defer wg.Done()
+			if err := search(b, distinctValues); err != nil {
+				anyErr.Store(fmt.Errorf("unexpected error searching completing block (%s): %w", b.BlockMeta().BlockID, err))
+				cancel()
+				return
+			}
+			if distinctValues.Exceeded() {
+				cancel()
+			}
+		}(b)
 	}
 	for _, b := range i.completeBlocks {
-		if err = search(b, distinctValues); err != nil {
-			return nil, fmt.Errorf("unexpected error searching complete block (%s): %w", b.BlockMeta().BlockID, err)
+		if distinctValues.Exceeded() || anyErr.Load() != nil {
+			break
 		}
+		wg.Add(1)
+		go func(b *LocalBlock) {
+			defer wg.Done()
+			if err := search(b, distinctValues); err != nil {
+				anyErr.Store(fmt.Errorf("unexpected error searching complete block (%s): %w", b.BlockMeta().BlockID, err))
+				cancel()
+				return
+			}
+			if distinctValues.Exceeded() {
+				cancel()
+			}
+		}(b)
+	}
+
+	wg.Wait()
+
+	if err := anyErr.Load(); err != nil {
+		return nil, err
 	}
 
 	if distinctValues.Exceeded() {
@@ -511,6 +813,21 @@ func (i *instance) SearchTagValuesV2(ctx context.Context, req *tempopb.SearchTag
 	return resp, nil
 }
 
+// defaultTagSearchConcurrentBlocks is used when the tenant has no override configured
+// for MaxConcurrentTagValuesQueries, i.e. the same fleet-wide default SearchTagValuesV2
+// has always used.
+const defaultTagSearchConcurrentBlocks = 20
+
+// tagSearchConcurrentBlocks returns the number of blocks SearchTagsV2 and
+// SearchTagValues are allowed to search concurrently for userID, falling back to
+// defaultTagSearchConcurrentBlocks when no per-tenant override is configured.
+func (i *instance) tagSearchConcurrentBlocks(userID string) int {
+	if n := i.limiter.limits.MaxConcurrentTagValuesQueries(userID); n > 0 {
+		return n
+	}
+	return defaultTagSearchConcurrentBlocks
+}
+
 // includeBlock uses the provided time range to determine if the block should be included in the search.
 func includeBlock(b *backend.BlockMeta, req *tempopb.SearchRequest) bool {
 	start := int64(req.Start)
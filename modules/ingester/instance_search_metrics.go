@@ -0,0 +1,136 @@
+package ingester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/atomic"
+
+	"github.com/go-kit/log/level"
+	"github.com/grafana/dskit/user"
+	"github.com/grafana/tempo/pkg/tempopb"
+	"github.com/grafana/tempo/pkg/traceql"
+	"github.com/grafana/tempo/pkg/util/log"
+	"github.com/grafana/tempo/tempodb/encoding/common"
+)
+
+// SearchMetrics executes a TraceQL metrics/aggregation query (e.g. `{ ... } | rate() by
+// (resource.service.name)`) against the headblock, completingBlocks and completeBlocks,
+// returning time-series covering whatever data is still resident in this ingester. It
+// mirrors the fan-out and locking pattern used by Search, but combines results with a
+// traceql.SeriesCombiner instead of a metadata combiner.
+func (i *instance) SearchMetrics(ctx context.Context, req *tempopb.QueryRangeRequest) (*tempopb.QueryRangeResponse, error) {
+	ctx, span := tracer.Start(ctx, "instance.SearchMetrics")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	span.AddEvent("QueryRangeRequest", trace.WithAttributes(attribute.String("request", req.String())))
+
+	var (
+		combinerMtx = sync.Mutex{}
+		combiner    = traceql.NewSeriesCombiner()
+		opts        = common.DefaultSearchOptions()
+		anyErr      atomic.Error
+	)
+
+	maxSeries := int(req.MaxSeries)
+
+	searchBlock := func(blockID uuid.UUID, block common.Searcher, spanName string) {
+		ctx, span := tracer.Start(ctx, "instance.searchMetricsBlock."+spanName)
+		defer span.End()
+
+		span.SetAttributes(attribute.String("blockID", blockID.String()))
+
+		// Every block gets its own compiled evaluator, mirroring the note on Search's
+		// per-block traceql.NewEngine() call: a MetricsEvaluator accumulates per-series
+		// state as it walks spans and isn't safe to share across concurrent blocks. We
+		// only ever read eval.Results() for the block that produced it, immediately
+		// below, so there's no cross-block accumulation to double-count either.
+		eval, err := traceql.NewEngine().CompileMetricsQueryRange(req, false, 0, false)
+		if err != nil {
+			anyErr.Store(fmt.Errorf("compiling metrics query for block (%s): %w", blockID, err))
+			cancel()
+			return
+		}
+
+		fetcher := traceql.NewSpansetFetcherWrapper(func(ctx context.Context, req traceql.FetchSpansRequest) (traceql.FetchSpansResponse, error) {
+			return block.Fetch(ctx, req, opts)
+		})
+
+		err = eval.Do(ctx, fetcher, uint64(req.Start), uint64(req.End))
+		if errors.Is(err, common.ErrUnsupported) {
+			level.Warn(log.Logger).Log("msg", "block does not support metrics search", "blockID", blockID)
+			return
+		}
+		if errors.Is(err, context.Canceled) {
+			// Ignore
+			return
+		}
+		if err != nil {
+			level.Error(log.Logger).Log("msg", "error searching block for metrics", "blockID", blockID, "err", err)
+			anyErr.Store(err)
+			cancel()
+			return
+		}
+
+		combinerMtx.Lock()
+		defer combinerMtx.Unlock()
+
+		// eval.Results() only ever reflects this block's own evaluator, so combining it
+		// here is a one-time delta merge, not a re-merge of earlier blocks' totals.
+		combiner.Combine(eval.Results())
+
+		if maxSeries > 0 && combiner.Count() >= maxSeries {
+			// We have enough series, cancel all other in-flight block searches.
+			cancel()
+		}
+	}
+
+	// Search headblock (synchronously), same lock ordering as Search.
+	i.headBlockMtx.RLock()
+	span.AddEvent("acquired headblock mtx")
+	searchBlock(i.headBlock.BlockMeta().BlockID, i.headBlock, "headBlock")
+	i.headBlockMtx.RUnlock()
+	if err := anyErr.Load(); err != nil {
+		return nil, err
+	}
+
+	i.blocksMtx.RLock()
+	defer i.blocksMtx.RUnlock()
+	span.AddEvent("acquired blocks mtx")
+
+	wg := sync.WaitGroup{}
+
+	for _, b := range i.completingBlocks {
+		wg.Add(1)
+		go func(b common.WALBlock) {
+			defer wg.Done()
+			searchBlock(b.BlockMeta().BlockID, b, "completingBlock")
+		}(b)
+	}
+
+	for _, b := range i.completeBlocks {
+		wg.Add(1)
+		go func(b *LocalBlock) {
+			defer wg.Done()
+			searchBlock(b.BlockMeta().BlockID, b, "completeBlock")
+		}(b)
+	}
+
+	wg.Wait()
+
+	if err := anyErr.Load(); err != nil {
+		return nil, err
+	}
+
+	return &tempopb.QueryRangeResponse{
+		Series: combiner.Results(),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package ingester
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/grafana/tempo/pkg/tempopb"
+	"github.com/grafana/tempo/pkg/traceql"
+)
+
+// TestSearchTagValuesQueryGating guards the routing decision instance.SearchTagValues
+// makes from req.Query: a query that mixes several scoped conditions must still be
+// treated as non-empty and sent through the filtered FetchTagValues/engine path, while
+// a query with no conditions (or no query at all) must take the fast, unfiltered
+// SearchTagValues path. This is the same class of "mingled conditions" bug seen in
+// vparquet3 autocomplete, where scoped and unscoped conditions got merged incorrectly.
+//
+// This exercises the exact predicate instance.SearchTagValues's search closure
+// evaluates (traceql.IsEmptyQuery(traceql.ExtractMatchers(req.Query))) against
+// *tempopb.SearchTagValuesRequest values built the way callers actually build them,
+// rather than instance.SearchTagValues itself end-to-end: doing that would require
+// fake WAL/complete blocks and a tenant limiter, whose concrete types live outside this
+// package slice.
+func TestSearchTagValuesQueryGating(t *testing.T) {
+	tcs := []struct {
+		name         string
+		tagName      string
+		query        string
+		wantUnscoped bool
+	}{
+		{name: "no query", tagName: "resource.namespace", query: "", wantUnscoped: true},
+		{name: "empty braces", tagName: "resource.namespace", query: "{}", wantUnscoped: true},
+		{name: "single scoped condition", tagName: "resource.namespace", query: `{ resource.service.name = "api" }`, wantUnscoped: false},
+		{name: "mixed resource and span scoped conditions", tagName: "resource.namespace", query: `{ resource.service.name = "api" && span.http.status_code = 500 }`, wantUnscoped: false},
+		{name: "mixed scoped and intrinsic conditions", tagName: "span.http.url", query: `{ span.http.method = "GET" && duration > 100ms }`, wantUnscoped: false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &tempopb.SearchTagValuesRequest{
+				TagName: tc.tagName,
+				Query:   tc.query,
+			}
+
+			matchers := traceql.ExtractMatchers(req.Query)
+			isUnscoped := traceql.IsEmptyQuery(matchers)
+			assert.Equal(t, tc.wantUnscoped, isUnscoped)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package overrides
+
+// Interface is the subset of the tenant overrides surface that modules/ingester's
+// search path calls by name. The real Overrides type implements many more getters for
+// other limits (MaxBytesPerTagValuesQuery, MaxBlocksPerTagValuesQuery, ...); this
+// interface only names the ones this series adds.
+type Interface interface {
+	// SearchIncludeLiveTraces returns userID's SearchIncludeLiveTraces override.
+	SearchIncludeLiveTraces(userID string) bool
+
+	// MaxConcurrentTagValuesQueries returns userID's MaxConcurrentTagValuesQueries
+	// override, or <= 0 if the tenant has none configured.
+	MaxConcurrentTagValuesQueries(userID string) int
+}
@@ -0,0 +1,31 @@
+package overrides
+
+// Limits holds the per-tenant ingester search options this series adds. The real
+// per-tenant Limits struct (modules/overrides) carries many more fields - for things
+// like MaxBytesPerTagValuesQuery and MaxBlocksPerTagValuesQuery - that already back
+// other i.limiter.limits calls in modules/ingester; this file only adds the two fields
+// below.
+type Limits struct {
+	// SearchIncludeLiveTraces controls whether TraceQL search against an ingester also
+	// searches its headblock while traces are still being appended, or skips the
+	// headblock and only searches completingBlocks/completeBlocks. See
+	// docs/configuration/per-tenant-overrides.md for the freshness/consistency
+	// trade-off this trades between.
+	SearchIncludeLiveTraces bool `yaml:"search_include_live_traces,omitempty"`
+
+	// MaxConcurrentTagValuesQueries caps how many blocks SearchTagsV2 and
+	// SearchTagValues search concurrently for this tenant. <= 0 means "use the
+	// ingester's own built-in default" (see modules/ingester's
+	// defaultTagSearchConcurrentBlocks).
+	MaxConcurrentTagValuesQueries int `yaml:"max_concurrent_tag_values_queries,omitempty"`
+}
+
+// DefaultLimits returns the defaults for the fields above.
+// SearchIncludeLiveTraces defaults to true, matching the ingester's behavior before this
+// override existed. MaxConcurrentTagValuesQueries defaults to 0, deferring to the
+// ingester's own built-in default.
+func DefaultLimits() Limits {
+	return Limits{
+		SearchIncludeLiveTraces: true,
+	}
+}
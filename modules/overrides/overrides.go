@@ -0,0 +1,34 @@
+package overrides
+
+// Overrides resolves per-tenant Limits, falling back to a fixed set of defaults for any
+// tenant without an override of its own.
+type Overrides struct {
+	defaults  Limits
+	perTenant map[string]Limits
+}
+
+// New creates an Overrides that returns perTenant[userID] when present, and defaults
+// otherwise.
+func New(defaults Limits, perTenant map[string]Limits) *Overrides {
+	return &Overrides{
+		defaults:  defaults,
+		perTenant: perTenant,
+	}
+}
+
+func (o *Overrides) limitsFor(userID string) Limits {
+	if l, ok := o.perTenant[userID]; ok {
+		return l
+	}
+	return o.defaults
+}
+
+// SearchIncludeLiveTraces implements Interface.
+func (o *Overrides) SearchIncludeLiveTraces(userID string) bool {
+	return o.limitsFor(userID).SearchIncludeLiveTraces
+}
+
+// MaxConcurrentTagValuesQueries implements Interface.
+func (o *Overrides) MaxConcurrentTagValuesQueries(userID string) int {
+	return o.limitsFor(userID).MaxConcurrentTagValuesQueries
+}
@@ -0,0 +1,43 @@
+package collector
+
+import "go.uber.org/atomic"
+
+// byteBudget is a wait-free, shareable byte counter used to enforce a total size cap
+// across one or more collectors. Checking Exceeded() never takes a lock, which matters
+// here because it is polled from the hot path of every block search goroutine.
+type byteBudget struct {
+	max int64
+	cur atomic.Int64
+}
+
+func newByteBudget(maxBytes int) *byteBudget {
+	return &byteBudget{max: int64(maxBytes)}
+}
+
+// tryAdd adds n bytes to the budget unless the running total has already reached max,
+// in which case it's a no-op. The add that crosses max is accepted, so the total can
+// end up past max by up to one value's size. A zero/negative max means unlimited.
+func (b *byteBudget) tryAdd(n int) bool {
+	if b.max <= 0 {
+		b.cur.Add(int64(n))
+		return true
+	}
+
+	for {
+		cur := b.cur.Load()
+		if cur >= b.max {
+			return false
+		}
+		if b.cur.CompareAndSwap(cur, cur+int64(n)) {
+			return true
+		}
+	}
+}
+
+func (b *byteBudget) Exceeded() bool {
+	return b.max > 0 && b.cur.Load() >= b.max
+}
+
+func (b *byteBudget) total() int {
+	return int(b.cur.Load())
+}
@@ -0,0 +1,124 @@
+package collector
+
+import "sync"
+
+// numShards controls how many independent locks DistinctString spreads its dedup set
+// across. Block search goroutines hash their strings into a shard and only ever
+// contend with other goroutines that happen to land on the same one, instead of a
+// single mutex shared by every concurrent Collect call in the instance.
+const numShards = 16
+
+type distinctStringShard struct {
+	mtx sync.Mutex
+	set map[string]struct{}
+}
+
+// DistinctString collects a deduplicated, size-bounded set of strings. It is lock-
+// sharded for the dedup set itself, tracks total bytes atomically so Exceeded() is
+// wait-free, and lazily materializes the sorted-by-insertion output only when Strings()
+// or Diff() is called.
+type DistinctString struct {
+	shards [numShards]distinctStringShard
+	budget *byteBudget
+
+	orderMtx sync.Mutex
+	order    []string
+	diffPos  int
+
+	onCollect func(string)
+}
+
+// NewDistinctString creates a DistinctString that stops accepting new values once the
+// running total of collected string bytes reaches maxDataSize. A maxDataSize <= 0 means
+// unlimited.
+func NewDistinctString(maxDataSize int) *DistinctString {
+	return newDistinctStringWithBudget(newByteBudget(maxDataSize))
+}
+
+func newDistinctStringWithBudget(budget *byteBudget) *DistinctString {
+	d := &DistinctString{budget: budget}
+	for s := range d.shards {
+		d.shards[s].set = make(map[string]struct{})
+	}
+	return d
+}
+
+// OnCollect registers a callback invoked synchronously every time Collect adds a
+// previously-unseen string. Callers use this to cancel sibling block searches the
+// instant the shared cap is hit, rather than waiting for their next Exceeded() poll
+// between blocks.
+func (d *DistinctString) OnCollect(f func(string)) {
+	d.onCollect = f
+}
+
+// Collect adds s to the set if it hasn't been seen before and the budget allows it.
+func (d *DistinctString) Collect(s string) {
+	if d.budget.Exceeded() {
+		return
+	}
+
+	shard := &d.shards[shardFor(s)]
+	shard.mtx.Lock()
+	if _, ok := shard.set[s]; ok {
+		shard.mtx.Unlock()
+		return
+	}
+	shard.set[s] = struct{}{}
+	shard.mtx.Unlock()
+
+	if !d.budget.tryAdd(len(s)) {
+		return
+	}
+
+	d.orderMtx.Lock()
+	d.order = append(d.order, s)
+	d.orderMtx.Unlock()
+
+	if d.onCollect != nil {
+		d.onCollect(s)
+	}
+}
+
+// Exceeded returns true once the configured byte budget has been reached.
+func (d *DistinctString) Exceeded() bool {
+	return d.budget.Exceeded()
+}
+
+// TotalDataSize returns the number of bytes collected so far.
+func (d *DistinctString) TotalDataSize() int {
+	return d.budget.total()
+}
+
+// Strings returns all distinct values collected so far.
+func (d *DistinctString) Strings() []string {
+	d.orderMtx.Lock()
+	defer d.orderMtx.Unlock()
+
+	out := make([]string, len(d.order))
+	copy(out, d.order)
+	return out
+}
+
+// Diff returns only the values collected since the last call to Diff (or since
+// construction, on the first call), enabling incremental streaming of tag values to the
+// frontend instead of re-sending the full set on every poll.
+func (d *DistinctString) Diff() []string {
+	d.orderMtx.Lock()
+	defer d.orderMtx.Unlock()
+
+	out := make([]string, len(d.order)-d.diffPos)
+	copy(out, d.order[d.diffPos:])
+	d.diffPos = len(d.order)
+	return out
+}
+
+// shardFor picks a shard deterministically from s using FNV-1a, which is cheap and
+// spreads typical tag-value strings evenly without needing a seeded hasher per shard.
+func shardFor(s string) uint32 {
+	var h uint32 = 2166136261
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h % numShards
+}
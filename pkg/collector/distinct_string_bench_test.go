@@ -0,0 +1,66 @@
+package collector
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+)
+
+// BenchmarkDistinctStringCollect measures Collect throughput with one worker and with
+// GOMAXPROCS workers (e.g. 32 on a 32-core box), collecting from a synthetic 1M-value
+// workload with heavy
+// duplication (as seen with real tag values, where cardinality is far lower than the
+// number of spans inspected).
+func BenchmarkDistinctStringCollect(b *testing.B) {
+	const workloadSize = 1_000_000
+	const cardinality = 50_000
+
+	values := make([]string, workloadSize)
+	for i := range values {
+		values[i] = fmt.Sprintf("value-%d", rand.Intn(cardinality))
+	}
+
+	for _, parallelism := range []int{1, runtime.GOMAXPROCS(0)} {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				d := NewDistinctString(0)
+
+				var wg fanOut
+				wg.goN(parallelism, func(worker int) {
+					for i := worker; i < len(values); i += parallelism {
+						d.Collect(values[i])
+					}
+				})
+				wg.wait()
+			}
+		})
+	}
+}
+
+// fanOut is a tiny helper to avoid pulling in sync.WaitGroup boilerplate repeatedly
+// across benchmark cases above.
+type fanOut struct {
+	done chan struct{}
+	n    int
+}
+
+func (f *fanOut) goN(n int, fn func(worker int)) {
+	f.done = make(chan struct{}, n)
+	f.n = n
+	for w := 0; w < n; w++ {
+		go func(worker int) {
+			fn(worker)
+			f.done <- struct{}{}
+		}(w)
+	}
+}
+
+func (f *fanOut) wait() {
+	for i := 0; i < f.n; i++ {
+		<-f.done
+	}
+}
@@ -0,0 +1,70 @@
+package collector
+
+import "sync"
+
+// DistinctValue collects a deduplicated, size-bounded set of comparable values of type
+// T, using a caller-supplied function to size each value (e.g. tempopb.TagValue, where
+// size is the combined length of its Type and Value fields).
+type DistinctValue[T comparable] struct {
+	budget *byteBudget
+	size   func(T) int
+
+	mtx  sync.Mutex
+	seen map[T]struct{}
+	vals []T
+}
+
+// NewDistinctValue creates a DistinctValue that stops accepting new values once the
+// running total reported by size reaches maxDataSize. A maxDataSize <= 0 means
+// unlimited.
+func NewDistinctValue[T comparable](maxDataSize int, size func(T) int) *DistinctValue[T] {
+	return &DistinctValue[T]{
+		budget: newByteBudget(maxDataSize),
+		size:   size,
+		seen:   make(map[T]struct{}),
+	}
+}
+
+// Collect adds v to the set if it hasn't been seen before and the budget allows it, and
+// reports whether the budget has now been exceeded.
+func (d *DistinctValue[T]) Collect(v T) bool {
+	if d.budget.Exceeded() {
+		return true
+	}
+
+	d.mtx.Lock()
+	if _, ok := d.seen[v]; ok {
+		d.mtx.Unlock()
+		return d.budget.Exceeded()
+	}
+	d.seen[v] = struct{}{}
+	d.mtx.Unlock()
+
+	if d.budget.tryAdd(d.size(v)) {
+		d.mtx.Lock()
+		d.vals = append(d.vals, v)
+		d.mtx.Unlock()
+	}
+
+	return d.budget.Exceeded()
+}
+
+// Exceeded returns true once the configured byte budget has been reached.
+func (d *DistinctValue[T]) Exceeded() bool {
+	return d.budget.Exceeded()
+}
+
+// TotalDataSize returns the number of bytes collected so far.
+func (d *DistinctValue[T]) TotalDataSize() int {
+	return d.budget.total()
+}
+
+// Values returns all distinct values collected so far.
+func (d *DistinctValue[T]) Values() []T {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	out := make([]T, len(d.vals))
+	copy(out, d.vals)
+	return out
+}
@@ -0,0 +1,101 @@
+package collector
+
+import "sync"
+
+// ScopedDistinctString is a DistinctString per scope (e.g. per TraceQL attribute scope),
+// all sharing a single byte budget so that the combined size across every scope is what
+// gets capped, matching how tag name/value queries report one overall limit regardless
+// of how many scopes contributed to it.
+type ScopedDistinctString struct {
+	budget *byteBudget
+
+	mtx  sync.RWMutex
+	cols map[string]*DistinctString
+
+	onCollect func(scope, value string)
+}
+
+// NewScopedDistinctString creates a ScopedDistinctString that stops accepting new
+// values once the running total of collected bytes, across all scopes, reaches
+// maxDataSize. A maxDataSize <= 0 means unlimited.
+func NewScopedDistinctString(maxDataSize int) *ScopedDistinctString {
+	return &ScopedDistinctString{
+		budget: newByteBudget(maxDataSize),
+		cols:   make(map[string]*DistinctString),
+	}
+}
+
+// OnCollect registers a callback invoked synchronously every time Collect adds a
+// previously-unseen value for any scope. Callers use this to cancel sibling block
+// searches the instant the shared cap is hit.
+func (d *ScopedDistinctString) OnCollect(f func(scope, value string)) {
+	d.onCollect = f
+}
+
+func (d *ScopedDistinctString) col(scope string) *DistinctString {
+	d.mtx.RLock()
+	col, ok := d.cols[scope]
+	d.mtx.RUnlock()
+	if ok {
+		return col
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if col, ok = d.cols[scope]; ok {
+		return col
+	}
+
+	col = newDistinctStringWithBudget(d.budget)
+	// d.onCollect is read at call time, so a callback registered after this scope was
+	// created still fires for it.
+	col.OnCollect(func(v string) {
+		if d.onCollect != nil {
+			d.onCollect(scope, v)
+		}
+	})
+	d.cols[scope] = col
+	return col
+}
+
+// Collect adds value to the set for the given scope, subject to the shared budget.
+func (d *ScopedDistinctString) Collect(scope, value string) {
+	d.col(scope).Collect(value)
+}
+
+// Exceeded returns true once the shared byte budget has been reached.
+func (d *ScopedDistinctString) Exceeded() bool {
+	return d.budget.Exceeded()
+}
+
+// TotalDataSize returns the number of bytes collected so far across all scopes.
+func (d *ScopedDistinctString) TotalDataSize() int {
+	return d.budget.total()
+}
+
+// Strings returns all distinct values collected so far, grouped by scope.
+func (d *ScopedDistinctString) Strings() map[string][]string {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	out := make(map[string][]string, len(d.cols))
+	for scope, col := range d.cols {
+		out[scope] = col.Strings()
+	}
+	return out
+}
+
+// Diff returns only the values collected since the last call to Diff, grouped by scope,
+// enabling incremental streaming of tag values to the frontend.
+func (d *ScopedDistinctString) Diff() map[string][]string {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	out := make(map[string][]string, len(d.cols))
+	for scope, col := range d.cols {
+		if vals := col.Diff(); len(vals) > 0 {
+			out[scope] = vals
+		}
+	}
+	return out
+}
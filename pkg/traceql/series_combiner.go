@@ -0,0 +1,84 @@
+package traceql
+
+import (
+	"sort"
+
+	"github.com/grafana/tempo/pkg/tempopb"
+)
+
+// SeriesCombiner merges the per-block (or per-ingester) time series produced by a
+// TraceQL metrics/aggregation query into one overall result, summing samples that share
+// the same label set at the same timestamp. It is the series equivalent of
+// MetadataCombiner: callers combine one source's results at a time and read back the
+// running total with Results.
+type SeriesCombiner struct {
+	series map[string]*tempopb.TimeSeries
+	order  []string
+}
+
+// NewSeriesCombiner creates an empty SeriesCombiner.
+func NewSeriesCombiner() *SeriesCombiner {
+	return &SeriesCombiner{
+		series: map[string]*tempopb.TimeSeries{},
+	}
+}
+
+// Combine merges series into the running result. Series sharing a PromLabels key with
+// one already seen have their samples summed timestamp-by-timestamp; new keys are added
+// as-is. It is not safe to call Combine concurrently; callers combining multiple sources
+// in parallel (e.g. one per block) must serialize their calls.
+func (c *SeriesCombiner) Combine(series []*tempopb.TimeSeries) {
+	for _, s := range series {
+		if s == nil {
+			continue
+		}
+
+		existing, ok := c.series[s.PromLabels]
+		if !ok {
+			c.series[s.PromLabels] = s
+			c.order = append(c.order, s.PromLabels)
+			continue
+		}
+
+		existing.Samples = mergeSamples(existing.Samples, s.Samples)
+	}
+}
+
+// Count returns the number of distinct series combined so far.
+func (c *SeriesCombiner) Count() int {
+	return len(c.series)
+}
+
+// Results returns the combined series, in the order each was first seen.
+func (c *SeriesCombiner) Results() []*tempopb.TimeSeries {
+	out := make([]*tempopb.TimeSeries, 0, len(c.order))
+	for _, key := range c.order {
+		out = append(out, c.series[key])
+	}
+	return out
+}
+
+// mergeSamples sums a and b by timestamp, returning the result ordered by timestamp.
+func mergeSamples(a, b []tempopb.Sample) []tempopb.Sample {
+	byTimestamp := make(map[int64]float64, len(a)+len(b))
+	order := make([]int64, 0, len(a)+len(b))
+
+	add := func(samples []tempopb.Sample) {
+		for _, s := range samples {
+			if _, ok := byTimestamp[s.TimestampMs]; !ok {
+				order = append(order, s.TimestampMs)
+			}
+			byTimestamp[s.TimestampMs] += s.Value
+		}
+	}
+	add(a)
+	add(b)
+
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+
+	out := make([]tempopb.Sample, 0, len(order))
+	for _, ts := range order {
+		out = append(out, tempopb.Sample{TimestampMs: ts, Value: byTimestamp[ts]})
+	}
+	return out
+}